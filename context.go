@@ -0,0 +1,80 @@
+package violetear
+
+import "net/http"
+
+// abortIndex is the sentinel index Abort jumps the chain to, past any
+// realistic number of middleware, so that Next's loop condition stops
+// advancing the chain without needing a separate "aborted" flag.
+const abortIndex = 1 << 30
+
+// Middleware is a function in a route's handler chain. It receives the
+// request Context and may call c.Next() to run the rest of the chain
+// before it returns, or c.Abort()/c.AbortWithStatus() to stop the chain
+// early. A middleware that returns without calling either lets the chain
+// continue with the next handler.
+type Middleware func(*Context)
+
+// Context carries the state of a single request as it flows through a
+// route's middleware chain.
+type Context struct {
+	Writer  http.ResponseWriter
+	Request *http.Request
+
+	handlers []Middleware
+	index    int
+	params   map[string]string
+}
+
+// Next runs the remaining handlers in the chain. Middleware call it to
+// continue execution after their own logic; the final handler in the
+// chain has nothing left to call and Next is a no-op there.
+func (c *Context) Next() {
+	c.index++
+	for c.index < len(c.handlers) {
+		c.handlers[c.index](c)
+		c.index++
+	}
+}
+
+// Abort stops the chain: any pending Next loops will see index past the
+// end of handlers and return without running the rest of the chain.
+func (c *Context) Abort() {
+	c.index = abortIndex
+}
+
+// IsAborted reports whether Abort has been called.
+func (c *Context) IsAborted() bool {
+	return c.index >= abortIndex
+}
+
+// AbortWithStatus writes the given status code and aborts the chain.
+func (c *Context) AbortWithStatus(code int) {
+	c.Writer.WriteHeader(code)
+	c.Abort()
+}
+
+// Param returns the value matched for the named dynamic segment (e.g.
+// c.Param(":uuid")), or "" if it wasn't part of the route.
+func (c *Context) Param(name string) string {
+	return c.params[name]
+}
+
+// Params returns all dynamic segment values matched for this request.
+func (c *Context) Params() map[string]string {
+	return c.params
+}
+
+// Route is returned by HandleFunc so that per-route middleware can be
+// attached after registration.
+type Route struct {
+	router *Router
+	leaf   *leaf
+}
+
+// Use appends mw to this route's middleware, after the router's global
+// middleware and before the final handler, and rebuilds the chain.
+func (rt *Route) Use(mw ...Middleware) *Route {
+	rt.leaf.routeMW = append(rt.leaf.routeMW, mw...)
+	rt.leaf.rebuild()
+	return rt
+}
@@ -0,0 +1,78 @@
+package violetear
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMiddlewareChain(t *testing.T) {
+	var order []string
+
+	logMW := func(c *Context) {
+		order = append(order, "log")
+		c.Next()
+	}
+	authMW := func(c *Context) {
+		order = append(order, "auth")
+		c.Next()
+	}
+
+	router := New()
+	router.Verbose = false
+	router.Use(logMW)
+
+	router.HandleFunc("/users/:uuid", func(w http.ResponseWriter, r *http.Request) {
+		order = append(order, "handler")
+		w.WriteHeader(http.StatusOK)
+	}, "GET").Use(authMW)
+
+	router.AddRegex(":uuid", `^[0-9a-fA-F-]{36}$`)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/users/3B96853C-EF0B-44BC-8820-A982A5756E25", nil)
+	router.ServeHTTP(w, req)
+
+	expect(t, w.Code, http.StatusOK)
+	expectDeepEqual(t, order, []string{"log", "auth", "handler"})
+}
+
+func TestMiddlewareAbort(t *testing.T) {
+	var ran bool
+
+	denyMW := func(c *Context) {
+		c.AbortWithStatus(http.StatusForbidden)
+	}
+
+	router := New()
+	router.Verbose = false
+	router.HandleFunc("/secret", func(w http.ResponseWriter, r *http.Request) {
+		ran = true
+	}, "GET").Use(denyMW)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/secret", nil)
+	router.ServeHTTP(w, req)
+
+	expect(t, w.Code, http.StatusForbidden)
+	expect(t, ran, false)
+}
+
+func TestContextParam(t *testing.T) {
+	var got string
+
+	router := New()
+	router.Verbose = false
+	router.AddRegex(":uuid", `^[0-9a-fA-F-]{36}$`)
+	router.HandleFunc("/users/:uuid", func(w http.ResponseWriter, r *http.Request) {}, "GET").
+		Use(func(c *Context) {
+			got = c.Param(":uuid")
+			c.Next()
+		})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/users/3B96853C-EF0B-44BC-8820-A982A5756E25", nil)
+	router.ServeHTTP(w, req)
+
+	expect(t, got, "3B96853C-EF0B-44BC-8820-A982A5756E25")
+}
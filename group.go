@@ -0,0 +1,53 @@
+package violetear
+
+import "net/http"
+
+// Group registers a family of routes under a shared path prefix and
+// middleware stack. It mirrors the Router's own registration API so that
+// callers can nest groups (g.Group("/admin", authMW)) without caring
+// whether they're starting from the root router or an existing group.
+type Group struct {
+	router     *Router
+	prefix     string
+	middleware []Middleware
+}
+
+// Group returns a Group rooted at prefix, with mw applied to every route
+// registered through it (and through any group nested inside it).
+func (r *Router) Group(prefix string, mw ...Middleware) *Group {
+	return &Group{
+		router:     r,
+		prefix:     prefix,
+		middleware: append([]Middleware(nil), mw...),
+	}
+}
+
+// HandleFunc registers fn under the group's prefix, see Router.HandleFunc.
+func (g *Group) HandleFunc(path string, fn http.HandlerFunc, methods ...string) *Route {
+	return g.router.register(g.prefix+path, fn, methods, g.middleware)
+}
+
+// Handle registers handler under the group's prefix, see Router.Handle.
+func (g *Group) Handle(path string, handler http.Handler, methods ...string) *Route {
+	return g.router.register(g.prefix+path, handler.ServeHTTP, methods, g.middleware)
+}
+
+// Use appends mw to the group's middleware. It applies to every route
+// registered through the group afterwards.
+func (g *Group) Use(mw ...Middleware) {
+	g.middleware = append(g.middleware, mw...)
+}
+
+// Group returns a nested group whose prefix is appended to this group's
+// prefix and whose middleware is this group's middleware plus mw.
+func (g *Group) Group(prefix string, mw ...Middleware) *Group {
+	childMW := make([]Middleware, 0, len(g.middleware)+len(mw))
+	childMW = append(childMW, g.middleware...)
+	childMW = append(childMW, mw...)
+
+	return &Group{
+		router:     g.router,
+		prefix:     g.prefix + prefix,
+		middleware: childMW,
+	}
+}
@@ -0,0 +1,55 @@
+package violetear
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGroupPrefixAndDynamicSegment(t *testing.T) {
+	router := New()
+	router.Verbose = false
+	router.AddRegex(":uuid", `^[0-9a-fA-F-]{36}$`)
+
+	api := router.Group("/api/v1")
+	api.HandleFunc("/users/:uuid", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}, "GET")
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/users/3B96853C-EF0B-44BC-8820-A982A5756E25", nil)
+	router.ServeHTTP(w, req)
+
+	expect(t, w.Code, http.StatusOK)
+}
+
+func TestGroupMiddlewareInheritance(t *testing.T) {
+	var order []string
+
+	authMW := func(c *Context) {
+		order = append(order, "auth")
+		c.Next()
+	}
+
+	router := New()
+	router.Verbose = false
+
+	admin := router.Group("/admin", authMW)
+	admin.HandleFunc("/stats", func(w http.ResponseWriter, r *http.Request) {
+		order = append(order, "handler")
+	}, "GET")
+
+	nested := admin.Group("/reports")
+	nested.HandleFunc("/daily", func(w http.ResponseWriter, r *http.Request) {
+		order = append(order, "handler")
+	}, "GET")
+
+	for _, path := range []string{"/admin/stats", "/admin/reports/daily"} {
+		order = nil
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", path, nil)
+		router.ServeHTTP(w, req)
+		expect(t, w.Code, http.StatusOK)
+		expectDeepEqual(t, order, []string{"auth", "handler"})
+	}
+}
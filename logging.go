@@ -0,0 +1,77 @@
+package violetear
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// LogEntry describes a single served request, passed to Router.Logger.
+type LogEntry struct {
+	Method   string
+	Pattern  string
+	Path     string
+	Status   int
+	Bytes    int
+	Duration time.Duration
+	Params   map[string]string
+}
+
+// responseWriter wraps http.ResponseWriter to record the status code and
+// number of bytes written, for LogEntry.
+type responseWriter struct {
+	http.ResponseWriter
+	status  int
+	bytes   int
+	written bool
+}
+
+func (w *responseWriter) WriteHeader(code int) {
+	if !w.written {
+		w.status = code
+		w.written = true
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *responseWriter) Write(p []byte) (int, error) {
+	if !w.written {
+		w.status = http.StatusOK
+		w.written = true
+	}
+	n, err := w.ResponseWriter.Write(p)
+	w.bytes += n
+	return n, err
+}
+
+// Flush delegates to the embedded writer's Flush when it implements
+// http.Flusher, so that wrapping with a Logger doesn't silently break
+// streaming handlers.
+func (w *responseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack delegates to the embedded writer's Hijack when it implements
+// http.Hijacker, so that wrapping with a Logger doesn't silently break
+// websocket/hijack handlers.
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("violetear: underlying ResponseWriter does not implement http.Hijacker")
+	}
+	return h.Hijack()
+}
+
+// Push delegates to the embedded writer's Push when it implements
+// http.Pusher.
+func (w *responseWriter) Push(target string, opts *http.PushOptions) error {
+	p, ok := w.ResponseWriter.(http.Pusher)
+	if !ok {
+		return http.ErrNotSupported
+	}
+	return p.Push(target, opts)
+}
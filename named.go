@@ -0,0 +1,67 @@
+package violetear
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Name records name as a reverse-lookup key for this route's pattern, so
+// it can later be rendered with URL or URLPath. Registering two routes
+// under the same name overwrites the earlier one.
+func (rt *Route) Name(name string) *Route {
+	rt.leaf.name = name
+	rt.router.names[name] = rt.leaf.pattern
+	return rt
+}
+
+// Named is a shorthand for HandleFunc(path, fn, methods...).Name(name).
+func (r *Router) Named(name, path string, fn http.HandlerFunc, methods ...string) *Route {
+	return r.HandleFunc(path, fn, methods...).Name(name)
+}
+
+// URL renders the pattern registered under name, substituting each
+// dynamic segment (":uuid", ":ip", ...) with the value supplied in
+// params. It returns an error if name is unknown, a required param is
+// missing, or a value fails the regex registered for it via AddRegex.
+func (r *Router) URL(name string, params map[string]string) (string, error) {
+	pattern, ok := r.names[name]
+	if !ok {
+		return "", fmt.Errorf("violetear: no route named %q", name)
+	}
+
+	segments := strings.Split(pattern, "/")
+	for i, seg := range segments {
+		if !strings.HasPrefix(seg, ":") {
+			continue
+		}
+
+		val, ok := params[seg]
+		if !ok {
+			return "", fmt.Errorf("violetear: %q: missing value for %q", name, seg)
+		}
+
+		if re, ok := r.regex[seg]; ok && !re.MatchString(val) {
+			return "", fmt.Errorf("violetear: %q: value %q for %q does not match its pattern", name, val, seg)
+		}
+
+		segments[i] = val
+	}
+
+	return strings.Join(segments, "/"), nil
+}
+
+// URLPath is URL with params passed as alternating key/value strings
+// (e.g. URLPath("user.show", ":uuid", id)) instead of a map.
+func (r *Router) URLPath(name string, pairs ...string) (string, error) {
+	if len(pairs)%2 != 0 {
+		return "", fmt.Errorf("violetear: URLPath: odd number of key/value arguments for %q", name)
+	}
+
+	params := make(map[string]string, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		params[pairs[i]] = pairs[i+1]
+	}
+
+	return r.URL(name, params)
+}
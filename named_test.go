@@ -0,0 +1,55 @@
+package violetear
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestURLRendersPattern(t *testing.T) {
+	router := New()
+	router.Verbose = false
+	router.AddRegex(":uuid", `^[0-9a-fA-F-]{36}$`)
+
+	router.HandleFunc("/users/:uuid", func(w http.ResponseWriter, r *http.Request) {}, "GET").
+		Name("user.show")
+
+	url, err := router.URL("user.show", map[string]string{
+		":uuid": "3B96853C-EF0B-44BC-8820-A982A5756E25",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect(t, url, "/users/3B96853C-EF0B-44BC-8820-A982A5756E25")
+}
+
+func TestURLErrors(t *testing.T) {
+	router := New()
+	router.Verbose = false
+	router.AddRegex(":uuid", `^[0-9a-fA-F-]{36}$`)
+	router.Named("user.show", "/users/:uuid", func(w http.ResponseWriter, r *http.Request) {}, "GET")
+
+	if _, err := router.URL("no.such.route", nil); err == nil {
+		t.Fatal("expected error for unknown route name")
+	}
+
+	if _, err := router.URL("user.show", map[string]string{}); err == nil {
+		t.Fatal("expected error for missing param")
+	}
+
+	if _, err := router.URL("user.show", map[string]string{":uuid": "not-a-uuid"}); err == nil {
+		t.Fatal("expected error for value failing regex")
+	}
+}
+
+func TestURLPath(t *testing.T) {
+	router := New()
+	router.Verbose = false
+	router.AddRegex(":uuid", `^[0-9a-fA-F-]{36}$`)
+	router.Named("user.show", "/users/:uuid", func(w http.ResponseWriter, r *http.Request) {}, "GET")
+
+	url, err := router.URLPath("user.show", ":uuid", "3B96853C-EF0B-44BC-8820-A982A5756E25")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect(t, url, "/users/3B96853C-EF0B-44BC-8820-A982A5756E25")
+}
@@ -0,0 +1,69 @@
+package violetear
+
+import (
+	"reflect"
+	"runtime"
+	"sort"
+)
+
+// RouteInfo describes a single registered route, as returned by Routes.
+type RouteInfo struct {
+	Pattern     string
+	Methods     []string
+	Name        string
+	HandlerName string
+}
+
+// Routes walks the router's trie once and returns a RouteInfo for every
+// registered route. It's meant for operators to expose their own
+// introspection endpoint (e.g. GET /debug/routes).
+func (r *Router) Routes() []RouteInfo {
+	var routes []RouteInfo
+
+	r.trie.walk(func(l *leaf) {
+		routes = append(routes, RouteInfo{
+			Pattern:     l.pattern,
+			Methods:     l.methodList(),
+			Name:        l.name,
+			HandlerName: handlerName(l.handler),
+		})
+	})
+
+	return routes
+}
+
+// walk visits every leaf reachable from t, in no particular order.
+func (t *trie) walk(visit func(*leaf)) {
+	if t.leaf != nil {
+		visit(t.leaf)
+	}
+	for _, child := range t.node {
+		child.walk(visit)
+	}
+	for _, child := range t.dynamic {
+		child.walk(visit)
+	}
+	if t.wildcard != nil {
+		t.wildcard.walk(visit)
+	}
+}
+
+// methodList returns the leaf's allowed methods, sorted, or ["ALL"] when
+// every method is allowed.
+func (l *leaf) methodList() []string {
+	if l.methods["ALL"] {
+		return []string{"ALL"}
+	}
+
+	methods := make([]string, 0, len(l.methods))
+	for m := range l.methods {
+		methods = append(methods, m)
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+// handlerName returns fn's fully qualified function name, for debugging.
+func handlerName(fn interface{}) string {
+	return runtime.FuncForPC(reflect.ValueOf(fn).Pointer()).Name()
+}
@@ -0,0 +1,146 @@
+package violetear
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRoutesIntrospection(t *testing.T) {
+	router := New()
+	router.Verbose = false
+	router.AddRegex(":uuid", `^[0-9a-fA-F-]{36}$`)
+
+	router.HandleFunc("/users/:uuid", func(w http.ResponseWriter, r *http.Request) {}, "GET, HEAD").
+		Name("user.show")
+	router.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {})
+
+	routes := router.Routes()
+	expect(t, len(routes), 2)
+
+	byName := make(map[string]RouteInfo, len(routes))
+	for _, rt := range routes {
+		byName[rt.Pattern] = rt
+	}
+
+	show := byName["/users/:uuid"]
+	expect(t, show.Name, "user.show")
+	expectDeepEqual(t, show.Methods, []string{"GET", "HEAD"})
+
+	list := byName["/users"]
+	expectDeepEqual(t, list.Methods, []string{"ALL"})
+}
+
+func TestLoggerRecordsRequest(t *testing.T) {
+	router := New()
+	router.Verbose = false
+	router.AddRegex(":uuid", `^[0-9a-fA-F-]{36}$`)
+	router.HandleFunc("/users/:uuid", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte("ok"))
+	}, "GET")
+
+	var entry LogEntry
+	router.Logger = func(e LogEntry) { entry = e }
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/users/3B96853C-EF0B-44BC-8820-A982A5756E25", nil)
+	router.ServeHTTP(w, req)
+
+	expect(t, entry.Method, "GET")
+	expect(t, entry.Pattern, "/users/:uuid")
+	expect(t, entry.Status, http.StatusCreated)
+	expect(t, entry.Bytes, 2)
+	expect(t, entry.Params[":uuid"], "3B96853C-EF0B-44BC-8820-A982A5756E25")
+}
+
+func TestLoggerRunsOnPanic(t *testing.T) {
+	router := New()
+	router.Verbose = false
+	router.HandleFunc("/boom", func(w http.ResponseWriter, r *http.Request) {
+		panic("kaboom")
+	}, "GET")
+
+	var logged bool
+	router.Logger = func(e LogEntry) { logged = true }
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic to propagate past the router")
+		}
+		if !logged {
+			t.Fatal("expected Logger to run despite the panic")
+		}
+	}()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/boom", nil)
+	router.ServeHTTP(w, req)
+}
+
+func TestLoggerReportsServerErrorOnPanic(t *testing.T) {
+	router := New()
+	router.Verbose = false
+	router.HandleFunc("/boom", func(w http.ResponseWriter, r *http.Request) {
+		panic("kaboom")
+	}, "GET")
+
+	var entry LogEntry
+	router.Logger = func(e LogEntry) { entry = e }
+
+	func() {
+		defer func() { recover() }()
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/boom", nil)
+		router.ServeHTTP(w, req)
+	}()
+
+	expect(t, entry.Status, http.StatusInternalServerError)
+}
+
+func TestLoggerRunsOnNotFound(t *testing.T) {
+	router := New()
+	router.Verbose = false
+	router.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {})
+
+	var entry LogEntry
+	router.Logger = func(e LogEntry) { entry = e }
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/missing", nil)
+	router.ServeHTTP(w, req)
+
+	expect(t, entry.Status, http.StatusNotFound)
+	expect(t, entry.Pattern, "")
+}
+
+func TestLoggerRunsOnMethodNotAllowed(t *testing.T) {
+	router := New()
+	router.Verbose = false
+	router.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {}, "GET")
+
+	var entry LogEntry
+	router.Logger = func(e LogEntry) { entry = e }
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/users", nil)
+	router.ServeHTTP(w, req)
+
+	expect(t, entry.Status, http.StatusMethodNotAllowed)
+	expect(t, entry.Pattern, "/users")
+}
+
+func TestLoggerPreservesFlusher(t *testing.T) {
+	router := New()
+	router.Verbose = false
+	router.HandleFunc("/stream", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := w.(http.Flusher); !ok {
+			t.Fatal("expected ResponseWriter to implement http.Flusher when Logger is set")
+		}
+	}, "GET")
+	router.Logger = func(e LogEntry) {}
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/stream", nil)
+	router.ServeHTTP(w, req)
+}
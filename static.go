@@ -0,0 +1,184 @@
+package violetear
+
+import (
+	"compress/gzip"
+	"fmt"
+	"html/template"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+)
+
+// StaticConfig tunes the behaviour of Router.Static.
+type StaticConfig struct {
+	// Index is the file served for a directory request, default
+	// "index.html".
+	Index string
+
+	// Browse lists a directory's contents when it has no Index file.
+	// When false such a request 404s.
+	Browse bool
+
+	// MaxAge, when greater than zero, sets Cache-Control: max-age=MaxAge
+	// (seconds) on every served file.
+	MaxAge int
+
+	// Compress gzips the response body when the client advertises
+	// Accept-Encoding: gzip.
+	Compress bool
+}
+
+// Static registers a wildcard route under prefix that serves files from
+// root on disk, stripping prefix from the request path before looking it
+// up with http.Dir. Anything that can't be found is answered through the
+// router's own NotFoundHandler rather than http.FileServer's default, so
+// it gets the same logging and headers as every other route.
+func (r *Router) Static(prefix, root string, cfg ...StaticConfig) *Route {
+	c := StaticConfig{Index: "index.html"}
+	if len(cfg) > 0 {
+		c = cfg[0]
+		if c.Index == "" {
+			c.Index = "index.html"
+		}
+	}
+
+	prefix = strings.TrimSuffix(prefix, "/")
+	dir := http.Dir(root)
+
+	handler := func(w http.ResponseWriter, req *http.Request) {
+		name := path.Clean("/" + strings.TrimPrefix(req.URL.Path, prefix))
+
+		f, fi, err := openStatic(dir, name, c.Index)
+		if err != nil {
+			r.notFound(w, req)
+			return
+		}
+		defer f.Close()
+
+		if fi.IsDir() {
+			if !c.Browse {
+				r.notFound(w, req)
+				return
+			}
+			serveDirListing(w, req, dir, name)
+			return
+		}
+
+		if c.MaxAge > 0 {
+			w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", c.MaxAge))
+		}
+
+		if c.Compress && acceptsGzip(req) {
+			serveGzip(w, req, f, fi)
+			return
+		}
+
+		http.ServeContent(w, req, fi.Name(), fi.ModTime(), f)
+	}
+
+	// The bare prefix (e.g. a request for "/assets" or "/") needs its own
+	// route alongside the wildcard, the same way the router's tests pair
+	// "/toor/" with "/toor/*": a wildcard only matches once there's at
+	// least one more path segment to capture.
+	bare := prefix
+	if bare == "" {
+		bare = "/"
+	}
+	r.HandleFunc(bare, handler, "GET")
+
+	return r.HandleFunc(prefix+"/*", handler, "GET")
+}
+
+// openStatic opens name under dir, following through to its Index file
+// when name is a directory that contains one.
+func openStatic(dir http.Dir, name, index string) (http.File, os.FileInfo, error) {
+	f, err := dir.Open(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+
+	if fi.IsDir() {
+		if idx, err := dir.Open(path.Join(name, index)); err == nil {
+			if idxInfo, err := idx.Stat(); err == nil && !idxInfo.IsDir() {
+				f.Close()
+				return idx, idxInfo, nil
+			}
+			idx.Close()
+		}
+	}
+
+	return f, fi, nil
+}
+
+// acceptsGzip reports whether req advertises gzip support.
+func acceptsGzip(req *http.Request) bool {
+	for _, enc := range strings.Split(req.Header.Get("Accept-Encoding"), ",") {
+		if strings.TrimSpace(enc) == "gzip" {
+			return true
+		}
+	}
+	return false
+}
+
+// serveGzip writes f to w gzip-encoded.
+func serveGzip(w http.ResponseWriter, req *http.Request, f http.File, fi os.FileInfo) {
+	w.Header().Set("Content-Encoding", "gzip")
+	w.Header().Set("Vary", "Accept-Encoding")
+
+	gz := gzip.NewWriter(w)
+	defer gz.Close()
+
+	http.ServeContent(&gzipResponseWriter{ResponseWriter: w, gz: gz}, req, fi.Name(), fi.ModTime(), f)
+}
+
+// gzipResponseWriter routes Write through a gzip.Writer while leaving
+// headers and status code untouched.
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	gz *gzip.Writer
+}
+
+func (g *gzipResponseWriter) Write(p []byte) (int, error) {
+	return g.gz.Write(p)
+}
+
+var dirListingTmpl = template.Must(template.New("dir").Parse(`<!DOCTYPE html>
+<pre>
+{{range .}}<a href="{{.}}">{{.}}</a>
+{{end}}</pre>
+`))
+
+// serveDirListing renders a minimal index of the directory at name.
+func serveDirListing(w http.ResponseWriter, req *http.Request, dir http.Dir, name string) {
+	f, err := dir.Open(name)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	entries, err := f.Readdir(-1)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		n := e.Name()
+		if e.IsDir() {
+			n += "/"
+		}
+		names = append(names, n)
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	dirListingTmpl.Execute(w, names)
+}
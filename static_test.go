@@ -0,0 +1,69 @@
+package violetear
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStaticServesFileAndStripsPrefix(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "hello.txt"), []byte("hi"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	router := New()
+	router.Verbose = false
+	router.Static("/assets", dir)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/assets/hello.txt", nil)
+	router.ServeHTTP(w, req)
+
+	expect(t, w.Code, http.StatusOK)
+	body, _ := io.ReadAll(w.Body)
+	expect(t, string(body), "hi")
+}
+
+func TestStaticMissingFileUsesNotFoundHandler(t *testing.T) {
+	dir := t.TempDir()
+
+	router := New()
+	router.Verbose = false
+
+	var called bool
+	router.NotFoundHandler = func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		http.NotFound(w, r)
+	}
+	router.Static("/assets", dir)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/assets/missing.txt", nil)
+	router.ServeHTTP(w, req)
+
+	expect(t, w.Code, http.StatusNotFound)
+	expect(t, called, true)
+}
+
+func TestStaticDirectoryIndex(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "index.html"), []byte("home"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	router := New()
+	router.Verbose = false
+	router.Static("/assets", dir)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/assets", nil)
+	router.ServeHTTP(w, req)
+
+	expect(t, w.Code, http.StatusOK)
+	body, _ := io.ReadAll(w.Body)
+	expect(t, string(body), "home")
+}
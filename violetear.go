@@ -0,0 +1,350 @@
+// Package violetear implements a lightweight HTTP request router.
+//
+// Routes are stored in a trie keyed by path segment. Each segment is
+// either static ("root"), dynamic (":uuid", validated against a regex
+// registered via AddRegex) or a wildcard ("*") that swallows the rest of
+// the path. Method matching happens at the leaf. Each path holds a single
+// registration: registering the same path again replaces the earlier
+// handler and its methods.
+package violetear
+
+import (
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Version of violetear.
+const Version = "1.0.0"
+
+// Router is an HTTP request multiplexer that dispatches to registered
+// routes based on a trie of path segments.
+type Router struct {
+	trie *trie
+
+	// regex holds the compiled expressions used to validate dynamic
+	// segments, keyed by their name (e.g. ":uuid").
+	regex map[string]*regexp.Regexp
+
+	// header is applied to every response before the matched handler
+	// runs, see SetHeader.
+	header http.Header
+
+	// middleware is global, applied to every route registered after
+	// the call to Use that added it.
+	middleware []Middleware
+
+	// names maps a route's Name to its registered pattern, for URL.
+	names map[string]string
+
+	// Verbose logs every route as it is registered.
+	Verbose bool
+
+	// NotFoundHandler, when set, replaces the default 404 response.
+	NotFoundHandler http.HandlerFunc
+
+	// Logger, when set, is called once a request has been served,
+	// whether it matched a route, hit NotFoundHandler, was rejected
+	// with 405 Method Not Allowed, or its handler panicked (in which
+	// case Status is reported as 500 before the panic is re-raised).
+	// This is independent of Verbose, which only logs at registration
+	// time.
+	Logger func(LogEntry)
+}
+
+// trie is a single node in the routing tree.
+type trie struct {
+	leaf *leaf
+
+	node     map[string]*trie // static children, keyed by segment
+	dynamic  map[string]*trie // dynamic children, keyed by e.g. ":uuid"
+	wildcard *trie            // "*" child, matches the rest of the path
+}
+
+// leaf is the registration stored at the end of a route's path.
+type leaf struct {
+	handler http.HandlerFunc
+	methods map[string]bool
+	pattern string
+	name    string
+
+	// globalMW is a snapshot of the router's middleware at the time
+	// this route was registered, followed by the middleware of the
+	// Group it was registered through, if any; routeMW is middleware attached via
+	// Route.Use. chain is the two concatenated with handler appended,
+	// rebuilt whenever either changes so that serving a request is
+	// just walking chain by index.
+	globalMW []Middleware
+	routeMW  []Middleware
+	chain    []Middleware
+}
+
+// allows reports whether method is permitted on this leaf.
+func (l *leaf) allows(method string) bool {
+	return l.methods["ALL"] || l.methods[method]
+}
+
+// rebuild recomputes chain from globalMW, routeMW and handler.
+func (l *leaf) rebuild() {
+	chain := make([]Middleware, 0, len(l.globalMW)+len(l.routeMW)+1)
+	chain = append(chain, l.globalMW...)
+	chain = append(chain, l.routeMW...)
+	chain = append(chain, func(c *Context) {
+		l.handler(c.Writer, c.Request)
+	})
+	l.chain = chain
+}
+
+// New returns a ready to use Router.
+func New() *Router {
+	header := make(http.Header)
+	header.Set("X-Powered-By", "violetear")
+
+	return &Router{
+		trie:    &trie{},
+		regex:   make(map[string]*regexp.Regexp),
+		header:  header,
+		names:   make(map[string]string),
+		Verbose: true,
+	}
+}
+
+// AddRegex registers the regular expression used to validate the dynamic
+// segment name, e.g. AddRegex(":uuid", `^[0-9a-f-]+$`).
+func (r *Router) AddRegex(name, expr string) {
+	r.regex[name] = regexp.MustCompile(expr)
+}
+
+// SetHeader adds a header that is written to every response.
+func (r *Router) SetHeader(key, value string) {
+	r.header.Set(key, value)
+}
+
+// Use appends mw to the router's global middleware. It applies to every
+// route registered afterwards; routes already registered are unaffected.
+func (r *Router) Use(mw ...Middleware) {
+	r.middleware = append(r.middleware, mw...)
+}
+
+// HandleFunc registers fn for path. methods is a comma separated list of
+// HTTP methods (e.g. "GET, HEAD"); it defaults to "ALL" when omitted,
+// allowing any method. The returned Route allows attaching per-route
+// middleware via Use.
+func (r *Router) HandleFunc(path string, fn http.HandlerFunc, methods ...string) *Route {
+	return r.register(path, fn, methods, nil)
+}
+
+// Handle registers handler for path, see HandleFunc.
+func (r *Router) Handle(path string, handler http.Handler, methods ...string) *Route {
+	return r.register(path, handler.ServeHTTP, methods, nil)
+}
+
+// register walks path into the trie and stores fn as its leaf. extraMW is
+// inserted between the router's global middleware and the route's own
+// middleware; it exists so Group can inject its middleware stack without
+// duplicating the trie-walking logic below.
+func (r *Router) register(path string, fn http.HandlerFunc, methods []string, extraMW []Middleware) *Route {
+	m := "ALL"
+	if len(methods) > 0 {
+		m = methods[0]
+	}
+
+	node := r.trie
+	for _, seg := range splitPath(path) {
+		switch {
+		case seg == "*":
+			if node.wildcard == nil {
+				node.wildcard = &trie{}
+			}
+			node = node.wildcard
+		case strings.HasPrefix(seg, ":"):
+			if node.dynamic == nil {
+				node.dynamic = make(map[string]*trie)
+			}
+			child, ok := node.dynamic[seg]
+			if !ok {
+				child = &trie{}
+				node.dynamic[seg] = child
+			}
+			node = child
+		default:
+			if node.node == nil {
+				node.node = make(map[string]*trie)
+			}
+			child, ok := node.node[seg]
+			if !ok {
+				child = &trie{}
+				node.node[seg] = child
+			}
+			node = child
+		}
+	}
+
+	globalMW := make([]Middleware, 0, len(r.middleware)+len(extraMW))
+	globalMW = append(globalMW, r.middleware...)
+	globalMW = append(globalMW, extraMW...)
+
+	node.leaf = &leaf{
+		handler:  fn,
+		methods:  parseMethods(m),
+		pattern:  path,
+		globalMW: globalMW,
+	}
+	node.leaf.rebuild()
+
+	if r.Verbose {
+		log.Printf("violetear: %-6s %s", m, path)
+	}
+
+	return &Route{router: r, leaf: node.leaf}
+}
+
+// ServeHTTP implements http.Handler.
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	for k, v := range r.header {
+		w.Header()[k] = v
+	}
+
+	params := make(map[string]string)
+	var pattern string
+
+	if r.Logger != nil {
+		rw := &responseWriter{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+
+		defer func() {
+			status := rw.status
+			if p := recover(); p != nil {
+				status = http.StatusInternalServerError
+				r.Logger(LogEntry{
+					Method:   req.Method,
+					Pattern:  pattern,
+					Path:     req.URL.Path,
+					Status:   status,
+					Bytes:    rw.bytes,
+					Duration: time.Since(start),
+					Params:   params,
+				})
+				panic(p)
+			}
+			r.Logger(LogEntry{
+				Method:   req.Method,
+				Pattern:  pattern,
+				Path:     req.URL.Path,
+				Status:   status,
+				Bytes:    rw.bytes,
+				Duration: time.Since(start),
+				Params:   params,
+			})
+		}()
+
+		w = rw
+	}
+
+	node := r.trie
+	segments := splitPath(req.URL.Path)
+
+	for i := 0; i < len(segments); i++ {
+		seg := segments[i]
+
+		if child, ok := node.node[seg]; ok {
+			node = child
+			continue
+		}
+
+		if child, name := r.matchDynamic(node, seg); child != nil {
+			params[name] = seg
+			node = child
+			continue
+		}
+
+		if node.wildcard != nil {
+			node = node.wildcard
+			break
+		}
+
+		r.notFound(w, req)
+		return
+	}
+
+	if node.leaf == nil {
+		r.notFound(w, req)
+		return
+	}
+
+	pattern = node.leaf.pattern
+
+	if !node.leaf.allows(req.Method) {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	c := &Context{
+		Writer:   w,
+		Request:  req,
+		handlers: node.leaf.chain,
+		index:    -1,
+		params:   params,
+	}
+	c.Next()
+}
+
+// matchDynamic returns the dynamic child of node whose regex matches seg,
+// along with its name, or nil/"" when none do.
+func (r *Router) matchDynamic(node *trie, seg string) (*trie, string) {
+	for name, child := range node.dynamic {
+		re, ok := r.regex[name]
+		if !ok {
+			continue
+		}
+		if re.MatchString(seg) {
+			return child, name
+		}
+	}
+	return nil, ""
+}
+
+// notFound answers req with the router's NotFoundHandler, or the default
+// http.NotFound when none is set.
+func (r *Router) notFound(w http.ResponseWriter, req *http.Request) {
+	if r.NotFoundHandler != nil {
+		r.NotFoundHandler(w, req)
+		return
+	}
+	http.NotFound(w, req)
+}
+
+// splitPath breaks path into its non-empty segments, so that leading,
+// trailing and repeated slashes are all ignored.
+func splitPath(path string) []string {
+	parts := strings.Split(path, "/")
+	segments := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p != "" {
+			segments = append(segments, p)
+		}
+	}
+	return segments
+}
+
+// parseMethods turns a comma separated method list into a lookup set.
+// An empty string or "ALL" allows every method.
+func parseMethods(methods string) map[string]bool {
+	set := make(map[string]bool)
+
+	methods = strings.TrimSpace(methods)
+	if methods == "" || strings.EqualFold(methods, "ALL") {
+		set["ALL"] = true
+		return set
+	}
+
+	for _, part := range strings.Split(methods, ",") {
+		part = strings.ToUpper(strings.TrimSpace(part))
+		if part != "" {
+			set[part] = true
+		}
+	}
+	return set
+}